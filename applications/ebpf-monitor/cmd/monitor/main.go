@@ -500,6 +500,9 @@ func (app *Application) startHTTPServer() error {
 		json.NewEncoder(w).Encode(app.getStats())
 	})
 
+	// Effective configuration (secrets redacted)
+	mux.Handle("/config", app.config.Handler())
+
 	// Root info
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -507,7 +510,7 @@ func (app *Application) startHTTPServer() error {
 			"service":     "eBPF Network Monitor",
 			"version":     "3.0.0",
 			"description": "Real-time network monitoring using eBPF + AI threat detection",
-			"endpoints": []string{"/health", "/stats", "/metrics"},
+			"endpoints": []string{"/health", "/stats", "/config", "/metrics"},
 		})
 	})
 
@@ -537,7 +540,7 @@ func (app *Application) startHTTPServer() error {
 
 // startMLClient sends data to ML Detector
 func (app *Application) startMLClient() {
-	log.Printf("🤖 ML client starting -> %s (every %v)", app.config.MLDetectorURL, app.config.PostInterval)
+	log.Printf("🤖 ML client starting -> %s (every %v)", config.RedactURL(app.config.MLDetectorURL), app.config.PostInterval)
 
 	go func() {
 		ticker := time.NewTicker(app.config.PostInterval)
@@ -634,7 +637,7 @@ func (app *Application) cleanup() {
 func (app *Application) Run() error {
 	log.Printf("🚀 Starting eBPF Network Monitor v3.0.0")
 	log.Printf("📊 Interface: %s, HTTP: %s, ML: %s",
-		app.config.Interface, app.config.HTTPAddr, app.config.MLDetectorURL)
+		app.config.Interface, app.config.HTTPAddr, config.RedactURL(app.config.MLDetectorURL))
 
 	// Setup eBPF program
 	if err := app.setupEBPF(); err != nil {
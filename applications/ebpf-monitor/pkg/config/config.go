@@ -1,21 +1,26 @@
 package config
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/url"
 	"os"
+	"reflect"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Interface         string
-	HTTPAddr          string
-	ReadTimeout       time.Duration
-	WriteTimeout      time.Duration
-	IdleTimeout       time.Duration
-	StatsWindow       time.Duration
-	PostInterval      time.Duration
-	MLDetectorURL     string
-	HTTPClientTimeout time.Duration
-	LogLevel          string
+	Interface         string        `json:"interface"`
+	HTTPAddr          string        `json:"http_addr"`
+	ReadTimeout       time.Duration `json:"read_timeout"`
+	WriteTimeout      time.Duration `json:"write_timeout"`
+	IdleTimeout       time.Duration `json:"idle_timeout"`
+	StatsWindow       time.Duration `json:"stats_window"`
+	PostInterval      time.Duration `json:"post_interval"`
+	MLDetectorURL     string        `json:"ml_detector_url" redact:"url"`
+	HTTPClientTimeout time.Duration `json:"http_client_timeout"`
+	LogLevel          string        `json:"log_level"`
 }
 
 func getenv(key, def string) string {
@@ -57,3 +62,49 @@ func New() Config {
 	}
 }
 
+// Redacted returns the effective configuration keyed by JSON tag, with
+// durations rendered as strings and fields tagged redact:"url" masked.
+func (c Config) Redacted() map[string]interface{} {
+	out := make(map[string]interface{})
+	v := reflect.ValueOf(c)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i).Interface()
+		switch {
+		case field.Tag.Get("redact") == "url":
+			value = RedactURL(v.Field(i).String())
+		case field.Type == reflect.TypeOf(time.Duration(0)):
+			value = value.(time.Duration).String()
+		}
+		out[field.Tag.Get("json")] = value
+	}
+	return out
+}
+
+// Handler serves the redacted configuration as JSON.
+func (c Config) Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(c.Redacted())
+	}
+}
+
+// RedactURL masks the password and every query value in raw, keeping the
+// parameter names and their original order.
+func RedactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "xxxxx"
+	}
+	if u.RawQuery != "" {
+		params := strings.Split(u.RawQuery, "&")
+		for i, p := range params {
+			if name, _, found := strings.Cut(p, "="); found {
+				params[i] = name + "=xxxxx"
+			}
+		}
+		u.RawQuery = strings.Join(params, "&")
+	}
+	return u.Redacted()
+}